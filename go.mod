@@ -4,6 +4,7 @@ go 1.24.11
 
 require (
 	github.com/gdamore/tcell/v2 v2.13.7
+	github.com/rivo/uniseg v0.4.7
 	github.com/zeebo/blake3 v0.2.4
 	golang.org/x/crypto v0.47.0
 )
@@ -12,7 +13,6 @@ require (
 	github.com/gdamore/encoding v1.0.1 // indirect
 	github.com/klauspost/cpuid/v2 v2.0.12 // indirect
 	github.com/lucasb-eyer/go-colorful v1.3.0 // indirect
-	github.com/rivo/uniseg v0.4.7 // indirect
 	golang.org/x/sys v0.40.0 // indirect
 	golang.org/x/term v0.39.0 // indirect
 	golang.org/x/text v0.33.0 // indirect
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package main
+
+import "os"
+
+// fileOwner is empty where files have no Unix owner
+func fileOwner(info os.FileInfo) string { return "" }
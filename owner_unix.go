@@ -0,0 +1,55 @@
+//go:build unix
+
+package main
+
+import (
+	"os"
+	"os/user"
+	"strconv"
+	"sync"
+	"syscall"
+)
+
+// Owner names are looked up once per id, directories share few owners
+var (
+	ownerNamesMu sync.Mutex
+	userNames    = map[uint32]string{}
+	groupNames   = map[uint32]string{}
+)
+
+// fileOwner returns "user:group" for a file, falling back to the numeric ids
+// when they have no name
+func fileOwner(info os.FileInfo) string {
+	st, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return ""
+	}
+	ownerNamesMu.Lock()
+	defer ownerNamesMu.Unlock()
+	return ownerName(userNames, uint32(st.Uid), func(id string) (string, error) {
+		u, err := user.LookupId(id)
+		if err != nil {
+			return "", err
+		}
+		return u.Username, nil
+	}) + ":" + ownerName(groupNames, uint32(st.Gid), func(id string) (string, error) {
+		g, err := user.LookupGroupId(id)
+		if err != nil {
+			return "", err
+		}
+		return g.Name, nil
+	})
+}
+
+// ownerName resolves id through the cache, calling lookup on a miss
+func ownerName(cache map[uint32]string, id uint32, lookup func(string) (string, error)) string {
+	if name, ok := cache[id]; ok {
+		return name
+	}
+	name, err := lookup(strconv.FormatUint(uint64(id), 10))
+	if err != nil || name == "" {
+		name = strconv.FormatUint(uint64(id), 10)
+	}
+	cache[id] = name
+	return name
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/gdamore/tcell/v2"
 )
 
 func TestInitThemes(t *testing.T) {
@@ -118,3 +122,74 @@ func TestThemeWrapAround(t *testing.T) {
 		t.Errorf("Expected Dark after wrap around, got %s", theme.Name)
 	}
 }
+
+func TestLoadThemesFromDir(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	jsonTheme := `{"Background": "#000010", "Foreground": "silver", "HeaderActive": "navy"}`
+	if err := os.WriteFile(filepath.Join(tmpDir, "midnight.json"), []byte(jsonTheme), 0644); err != nil {
+		t.Fatalf("Failed to write theme file: %v", err)
+	}
+
+	tomlTheme := "# Forest theme\nname = \"Forest\"\nstatus_bar_background = \"#228b22\"\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "forest.toml"), []byte(tomlTheme), 0644); err != nil {
+		t.Fatalf("Failed to write theme file: %v", err)
+	}
+
+	// Invalid file should be reported but not stop the others from loading
+	if err := os.WriteFile(filepath.Join(tmpDir, "broken.json"), []byte("{not json"), 0644); err != nil {
+		t.Fatalf("Failed to write theme file: %v", err)
+	}
+
+	themes, errs := loadThemesFromDir(tmpDir)
+	if len(errs) != 1 {
+		t.Errorf("Expected 1 error for the broken file, got %d: %v", len(errs), errs)
+	}
+	if len(themes) != 2 {
+		t.Fatalf("Expected 2 themes, got %d", len(themes))
+	}
+
+	byName := make(map[string]Theme)
+	for _, theme := range themes {
+		byName[theme.Name] = theme
+	}
+
+	midnight, ok := byName["midnight"]
+	if !ok {
+		t.Fatal("Expected theme named midnight")
+	}
+	if midnight.Background != tcell.NewHexColor(0x000010) {
+		t.Errorf("Unexpected background color: %v", midnight.Background)
+	}
+	if midnight.Foreground != tcell.ColorSilver {
+		t.Errorf("Unexpected foreground color: %v", midnight.Foreground)
+	}
+	// Unspecified fields fall back to the default theme
+	if midnight.DiffAdd != getDefaultTheme().DiffAdd {
+		t.Errorf("Expected default DiffAdd color, got %v", midnight.DiffAdd)
+	}
+
+	forest, ok := byName["Forest"]
+	if !ok {
+		t.Fatal("Expected theme named Forest")
+	}
+	if forest.StatusBarBackground != tcell.NewHexColor(0x228b22) {
+		t.Errorf("Unexpected status bar color: %v", forest.StatusBarBackground)
+	}
+}
+
+func TestLoadThemesFromMissingDir(t *testing.T) {
+	themes, errs := loadThemesFromDir(filepath.Join(t.TempDir(), "missing"))
+	if len(themes) != 0 || len(errs) != 0 {
+		t.Errorf("Expected no themes and no errors, got %d themes, %d errors", len(themes), len(errs))
+	}
+}
+
+func TestThemeFromFieldsInvalidColor(t *testing.T) {
+	if _, err := themeFromFields("bad", map[string]string{"Background": "notacolor"}); err == nil {
+		t.Error("Expected an error for an invalid color")
+	}
+	if _, err := themeFromFields("bad", map[string]string{"NoSuchField": "red"}); err == nil {
+		t.Error("Expected an error for an unknown field")
+	}
+}